@@ -4,14 +4,43 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 )
 
 // convertValue recursively converts a single reflect.Value to the target type.
-func convertValue(source reflect.Value, targetType reflect.Type) (reflect.Value, error) {
+func convertValue(source reflect.Value, targetType reflect.Type, ctx *conversionContext) (reflect.Value, error) {
 	// Handle nil source value and zero initialization for pointers and interfaces
 	if !source.IsValid() {
 		return reflect.Zero(targetType), nil
 	}
+
+	if fn, ok := ctx.lookup(source.Type(), targetType); ok {
+		return callTypeConverter(fn, source, targetType, ctx)
+	}
+
+	// In DeepCopy mode, pointers are tracked by source address so that
+	// self-referential graphs (linked lists, tree parents, ...) convert
+	// without looping forever and shared nodes still end up shared on the
+	// target side rather than duplicated.
+	if ctx.shouldDeepCopy() && targetType.Kind() == reflect.Ptr && source.Kind() == reflect.Ptr {
+		if source.IsNil() {
+			return reflect.Zero(targetType), nil
+		}
+		if existing, ok := ctx.visitedPointer(source.Pointer()); ok {
+			return existing, nil
+		}
+
+		targetPtr := reflect.New(targetType.Elem())
+		ctx.recordPointer(source.Pointer(), targetPtr)
+
+		converted, convErr := convertValue(source.Elem(), targetType.Elem(), ctx)
+		if convErr != nil {
+			return reflect.Value{}, convErr
+		}
+		targetPtr.Elem().Set(converted)
+		return targetPtr, nil
+	}
+
 	// Adjust for the target being a pointer or the source being a pointer
 	if targetType.Kind() == reflect.Ptr {
 		// Target is a pointer type
@@ -24,7 +53,7 @@ func convertValue(source reflect.Value, targetType reflect.Type) (reflect.Value,
 		targetPtr := reflect.New(targetType.Elem())
 
 		// Convert the dereferenced source to the target's element type
-		converted, convErr := convertValue(source, targetType.Elem())
+		converted, convErr := convertValue(source, targetType.Elem(), ctx)
 		if convErr != nil {
 			return reflect.Value{}, convErr
 		}
@@ -34,15 +63,18 @@ func convertValue(source reflect.Value, targetType reflect.Type) (reflect.Value,
 		return targetPtr, nil
 	} else if source.Kind() == reflect.Ptr {
 		// Source is a pointer but target is not, dereference source and continue
-		return convertValue(source.Elem(), targetType)
+		return convertValue(source.Elem(), targetType, ctx)
 	}
 
 	// Handling for non-pointer types or after adjustments for pointers
 	switch source.Kind() {
 	case reflect.Struct, reflect.Slice, reflect.Array, reflect.Map:
 		// Delegate to specific conversion functions based on the kind of source
-		return convertBasedOnKind(source, targetType)
+		return convertBasedOnKind(source, targetType, ctx)
 	default:
+		if converted, ok, convErr := tryWellKnownConversion(source, targetType, ctx); ok {
+			return converted, convErr
+		}
 		// Direct assignment or conversion for scalar and other types
 		if source.Type().AssignableTo(targetType) {
 			return source, nil
@@ -50,10 +82,31 @@ func convertValue(source reflect.Value, targetType reflect.Type) (reflect.Value,
 			return source.Convert(targetType), nil
 		}
 	}
-	return reflect.Value{}, fmt.Errorf("cannot convert type   %s   to   %s  ", source.Type(), targetType)
+	return reflect.Value{}, ctx.wrapErr(fmt.Errorf("cannot convert type   %s   to   %s  ", source.Type(), targetType))
+}
+
+// callTypeConverter invokes a registered TypeConverter.Fn, handing it the
+// Scope for the field currently being converted, and reflects its result
+// back into a reflect.Value of targetType.
+func callTypeConverter(fn func(interface{}, Scope) (interface{}, error), source reflect.Value, targetType reflect.Type, ctx *conversionContext) (reflect.Value, error) {
+	result, err := fn(source.Interface(), ctx.newScope(source.Type(), targetType))
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	if result == nil {
+		return reflect.Zero(targetType), nil
+	}
+	resultVal := reflect.ValueOf(result)
+	if !resultVal.Type().AssignableTo(targetType) {
+		if !resultVal.Type().ConvertibleTo(targetType) {
+			return reflect.Value{}, fmt.Errorf("registered converter returned %s, not assignable or convertible to %s", resultVal.Type(), targetType)
+		}
+		resultVal = resultVal.Convert(targetType)
+	}
+	return resultVal, nil
 }
 
-func convertBasedOnKind(source reflect.Value, targetType reflect.Type) (reflect.Value, error) {
+func convertBasedOnKind(source reflect.Value, targetType reflect.Type, ctx *conversionContext) (reflect.Value, error) {
 	// First, handle the case where source is a nil pointer.
 	if source.Kind() == reflect.Ptr && source.IsNil() {
 		// If the target is also a pointer type, return a nil pointer of that type.
@@ -70,27 +123,66 @@ func convertBasedOnKind(source reflect.Value, targetType reflect.Type) (reflect.
 		source = source.Elem()
 	}
 
+	// A target interface just needs the source to implement it; this must
+	// run before the kind switch below so it also covers struct/slice/map
+	// sources (e.g. converting a concrete type into an io.Writer field).
+	if targetType.Kind() == reflect.Interface {
+		if source.Type().Implements(targetType) {
+			return source, nil
+		}
+		return reflect.Value{}, ctx.wrapErr(fmt.Errorf("%s does not implement %s", source.Type(), targetType))
+	}
+
+	// database/sql Scanner/Valuer and encoding.TextMarshaler/TextUnmarshaler
+	// pairs can appear on either side regardless of the source's kind (e.g.
+	// sql.NullString, a struct, converting into a plain string), so this is
+	// also checked ahead of the kind switch.
+	if converted, ok, convErr := tryWellKnownConversion(source, targetType, ctx); ok {
+		return converted, convErr
+	}
+
 	// Determine how to convert based on the source's kind.
 	switch source.Kind() {
 	case reflect.Struct:
-		return convertStruct(source, targetType)
+		return convertStruct(source, targetType, ctx)
 	case reflect.Slice, reflect.Array:
-		return convertSlice(source, targetType)
+		return convertSlice(source, targetType, ctx)
 	case reflect.Map:
-		return convertMap(source, targetType)
+		return convertMap(source, targetType, ctx)
 	default:
 		// Handle basic type conversion and pointers specially.
-		return handleBasicTypesAndPointers(source, targetType)
+		return handleBasicTypesAndPointers(source, targetType, ctx)
 	}
 }
 
-func handleBasicTypesAndPointers(source reflect.Value, targetType reflect.Type) (reflect.Value, error) {
+func handleBasicTypesAndPointers(source reflect.Value, targetType reflect.Type, ctx *conversionContext) (reflect.Value, error) {
+	if fn, ok := ctx.lookup(source.Type(), targetType); ok {
+		return callTypeConverter(fn, source, targetType, ctx)
+	}
+
 	// If the target type is a pointer, we need to create a new instance of the type
 	// that the pointer points to, set the value, and then return the pointer.
 	if targetType.Kind() == reflect.Ptr {
 		// Create a new pointer of the target type.
 		targetPtr := reflect.New(targetType.Elem())
 
+		if fn, ok := ctx.lookup(source.Type(), targetType.Elem()); ok {
+			converted, err := callTypeConverter(fn, source, targetType.Elem(), ctx)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			targetPtr.Elem().Set(converted)
+			return targetPtr, nil
+		}
+
+		if converted, ok, err := tryWellKnownConversion(source, targetType.Elem(), ctx); ok {
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			targetPtr.Elem().Set(converted)
+			return targetPtr, nil
+		}
+
 		// If the source can be directly assigned to the target, do so.
 		// Otherwise, attempt to convert if the types are convertible.
 		if source.Type().AssignableTo(targetType.Elem()) {
@@ -98,12 +190,16 @@ func handleBasicTypesAndPointers(source reflect.Value, targetType reflect.Type)
 		} else if source.Type().ConvertibleTo(targetType.Elem()) {
 			targetPtr.Elem().Set(source.Convert(targetType.Elem()))
 		} else {
-			return reflect.Value{}, errors.New("conversion not supported")
+			return reflect.Value{}, ctx.wrapErr(errors.New("conversion not supported"))
 		}
 
 		return targetPtr, nil
 	}
 
+	if converted, ok, err := tryWellKnownConversion(source, targetType, ctx); ok {
+		return converted, err
+	}
+
 	// For non-pointer target types, directly assign or convert the value.
 	if source.Type().AssignableTo(targetType) {
 		return source, nil
@@ -111,10 +207,10 @@ func handleBasicTypesAndPointers(source reflect.Value, targetType reflect.Type)
 		return source.Convert(targetType), nil
 	}
 
-	return reflect.Value{}, errors.New("conversion not supported")
+	return reflect.Value{}, ctx.wrapErr(errors.New("conversion not supported"))
 }
 
-func convertStruct(source reflect.Value, targetType reflect.Type) (reflect.Value, error) {
+func convertStruct(source reflect.Value, targetType reflect.Type, ctx *conversionContext) (reflect.Value, error) {
 	// Ensure we're dealing with structs.
 	if source.Kind() == reflect.Ptr {
 		source = source.Elem()
@@ -124,36 +220,51 @@ func convertStruct(source reflect.Value, targetType reflect.Type) (reflect.Value
 	}
 
 	if source.Kind() != reflect.Struct || targetType.Kind() != reflect.Struct {
-		return reflect.Value{}, errors.New("source or target type is not struct or pointer to struct")
+		return reflect.Value{}, ctx.wrapErr(errors.New("source or target type is not struct or pointer to struct"))
+	}
+
+	if fn, ok := ctx.lookup(source.Type(), targetType); ok {
+		return callTypeConverter(fn, source, targetType, ctx)
 	}
 
 	target := reflect.New(targetType).Elem()
 
 	sourceType := source.Type()
-	for i := 0; i < source.NumField(); i++ {
-		sourceField := source.Field(i)
-		sourceFieldName := sourceType.Field(i).Name
-		targetField := target.FieldByName(sourceFieldName)
+	plan := buildFieldPlan(sourceType, targetType, ctx.fieldTagName())
+	for _, route := range plan {
+		if route.ignore {
+			continue
+		}
 
+		sourceField := source.Field(route.sourceIndex)
+		sourceFieldName := sourceType.Field(route.sourceIndex).Name
+
+		targetField, resolveErr := resolveTargetField(target, route.targetPath)
 		// Skip if the target does not have a corresponding field or if it can't be set.
-		if !targetField.IsValid() || !targetField.CanSet() {
+		if resolveErr != nil || !targetField.IsValid() || !targetField.CanSet() {
 			continue
 		}
 
+		if (ctx.shouldIgnoreEmpty() || route.ignoreEmpty) && sourceField.IsZero() {
+			continue
+		}
+
+		ctx.pushPath(sourceFieldName)
+
 		// Attempt conversion based on the kind of the source field.
 		var err error
 		switch sourceField.Kind() {
 		case reflect.Struct:
 			if targetField.Kind() == reflect.Ptr {
 				// Handle struct to pointer conversion
-				val, convErr := convertStruct(sourceField, targetField.Type().Elem())
+				val, convErr := convertStruct(sourceField, targetField.Type().Elem(), ctx)
 				if convErr == nil {
 					targetField.Set(val.Addr())
 				} else {
 					err = convErr
 				}
 			} else {
-				val, convErr := convertStruct(sourceField, targetField.Type())
+				val, convErr := convertStruct(sourceField, targetField.Type(), ctx)
 				if convErr == nil {
 					targetField.Set(val)
 				} else {
@@ -161,25 +272,25 @@ func convertStruct(source reflect.Value, targetType reflect.Type) (reflect.Value
 				}
 			}
 		case reflect.Slice, reflect.Array:
-			convertedSlice, convErr := convertSlice(sourceField, targetField.Type())
+			convertedSlice, convErr := convertSlice(sourceField, targetField.Type(), ctx)
 			if convErr == nil {
 				targetField.Set(convertedSlice)
 			} else {
 				err = convErr
 			}
 		case reflect.Map:
-			convertedMap, convErr := convertMap(sourceField, targetField.Type())
+			convertedMap, convErr := convertMap(sourceField, targetField.Type(), ctx)
 			if convErr == nil {
 				targetField.Set(convertedMap)
 			} else {
 				err = convErr
 			}
 		default:
-			if sourceField.Type().AssignableTo(targetField.Type()) {
+			if !ctx.shouldDeepCopy() && sourceField.Type().AssignableTo(targetField.Type()) {
 				targetField.Set(sourceField)
 			} else {
 				// Handle other types, possibly using convertValue for basic types or customized conversion.
-				convertedValue, convErr := convertValue(sourceField, targetField.Type())
+				convertedValue, convErr := convertValue(sourceField, targetField.Type(), ctx)
 				if convErr == nil && convertedValue.IsValid() {
 					targetField.Set(convertedValue)
 				} else {
@@ -188,54 +299,64 @@ func convertStruct(source reflect.Value, targetType reflect.Type) (reflect.Value
 			}
 		}
 
+		mustErr := route.must && targetField.IsZero()
+		ctx.popPath()
+
 		if err != nil {
 			return reflect.Value{}, err
 		}
+		if mustErr {
+			return reflect.Value{}, ctx.wrapErr(fmt.Errorf("field %q is required but was not populated", strings.Join(route.targetPath, ".")))
+		}
 	}
 
 	return target, nil
 }
 
-func convertSlice(source reflect.Value, targetType reflect.Type) (reflect.Value, error) {
+func convertSlice(source reflect.Value, targetType reflect.Type, ctx *conversionContext) (reflect.Value, error) {
 	if targetType.Kind() != reflect.Slice {
-		return reflect.Value{}, errors.New("target type is not a slice")
+		return reflect.Value{}, ctx.wrapErr(errors.New("target type is not a slice"))
 	}
 	elemType := targetType.Elem()
 	targetSlice := reflect.MakeSlice(targetType, source.Len(), source.Cap())
 
 	for i := 0; i < source.Len(); i++ {
 		sourceElem := source.Index(i)
-		convertedElem, err := convertValue(sourceElem, elemType)
-		if err != nil {
-			return reflect.Value{}, fmt.Errorf("failed to convert slice element: %v", err)
+		ctx.pushPath(fmt.Sprintf("[%d]", i))
+		convertedElem, err := convertValue(sourceElem, elemType, ctx)
+		if err == nil && !convertedElem.IsValid() {
+			err = ctx.wrapErr(errors.New("converted slice element is invalid"))
 		}
-		// Check if convertedElem is valid before setting it on the targetSlice.
-		if convertedElem.IsValid() {
-			targetSlice.Index(i).Set(convertedElem)
-		} else {
-			return reflect.Value{}, fmt.Errorf("converted slice element is invalid")
+		ctx.popPath()
+		if err != nil {
+			return reflect.Value{}, err
 		}
+		targetSlice.Index(i).Set(convertedElem)
 	}
 	return targetSlice, nil
 }
 
 // convertMap handles map-to-map conversion, key by key and value by value.
-func convertMap(source reflect.Value, targetType reflect.Type) (reflect.Value, error) {
+func convertMap(source reflect.Value, targetType reflect.Type, ctx *conversionContext) (reflect.Value, error) {
 	if targetType.Kind() != reflect.Map {
-		return reflect.Value{}, errors.New("target type is not a map")
+		return reflect.Value{}, ctx.wrapErr(errors.New("target type is not a map"))
 	}
 	targetMap := reflect.MakeMapWithSize(targetType, source.Len())
 	for _, key := range source.MapKeys() {
 		sourceValue := source.MapIndex(key)
-		convertedKey, err := convertValue(key, targetType.Key())
-		if err != nil {
-			return reflect.Value{}, err
+		ctx.pushPath(fmt.Sprintf("[%v]", key.Interface()))
+		convertedKey, err := convertValue(key, targetType.Key(), ctx)
+		if err == nil {
+			var convertedValue reflect.Value
+			convertedValue, err = convertValue(sourceValue, targetType.Elem(), ctx)
+			if err == nil {
+				targetMap.SetMapIndex(convertedKey, convertedValue)
+			}
 		}
-		convertedValue, err := convertValue(sourceValue, targetType.Elem())
+		ctx.popPath()
 		if err != nil {
 			return reflect.Value{}, err
 		}
-		targetMap.SetMapIndex(convertedKey, convertedValue)
 	}
 	return targetMap, nil
 }
@@ -243,6 +364,27 @@ func convertMap(source reflect.Value, targetType reflect.Type) (reflect.Value, e
 // ConvertStructs dynamically converts fields from a source struct to a target struct using pointers.
 // It uses struct field names for matching by default but can also use a specified struct tag for matching.
 func ConvertStructs[Source any, Target any](source *Source, target *Target, tagName string) error {
+	return convertStructsWithContext(source, target, tagName, nil)
+}
+
+// ConvertStructsWithOptions behaves like ConvertStructs but accepts an Options
+// struct to register custom TypeConverters and toggle IgnoreEmpty/DeepCopy
+// semantics for this conversion.
+func ConvertStructsWithOptions[Source any, Target any](source *Source, target *Target, tagName string, opts Options) error {
+	return convertStructsWithContext(source, target, tagName, newConversionContext(opts))
+}
+
+// ConvertStructsWithScope behaves like ConvertStructsWithOptions, additionally
+// routing trace output to logger, which may be nil. As with the other entry
+// points, conversion errors are wrapped with the field path at which they
+// occurred (e.g. "failed at User.Addresses[3].ZIP: ...").
+func ConvertStructsWithScope[Source any, Target any](source *Source, target *Target, tagName string, opts Options, logger DebugLogger) error {
+	ctx := newConversionContext(opts)
+	ctx.logger = logger
+	return convertStructsWithContext(source, target, tagName, ctx)
+}
+
+func convertStructsWithContext[Source any, Target any](source *Source, target *Target, tagName string, ctx *conversionContext) error {
 	sourceVal := reflect.ValueOf(*source) //reflect.ValueOf(*source)
 	targetVal := reflect.ValueOf(target).Elem()
 
@@ -250,100 +392,127 @@ func ConvertStructs[Source any, Target any](source *Source, target *Target, tagN
 		return errors.New("source or target is not a struct")
 	}
 
-	for i := 0; i < sourceVal.NumField(); i++ {
-		sourceField := sourceVal.Field(i)
-		sourceTypeField := sourceVal.Type().Field(i)
-		tagValue := sourceTypeField.Tag.Get(tagName)
+	// Carry tagName on the context so nested convertStruct calls apply the
+	// same tag-driven field plan as this top-level entry point.
+	if ctx == nil {
+		ctx = newConversionContext(Options{})
+	}
+	ctx.tagName = tagName
+
+	plan := buildFieldPlan(sourceVal.Type(), targetVal.Type(), tagName)
+
+	for _, route := range plan {
+		if route.ignore {
+			continue
+		}
+
+		sourceField := sourceVal.Field(route.sourceIndex)
 		if !sourceField.CanInterface() {
 			continue
 		}
 
-		var targetField reflect.Value
-		if tagName != "" {
-			tagValue = sourceTypeField.Tag.Get(tagName)
-			if tagValue != "" {
-				targetField = targetVal.FieldByName(tagValue)
-			}
-			if !targetField.IsValid() || !targetField.CanSet() {
-				targetField = targetVal.FieldByName(sourceTypeField.Name)
-			}
+		targetField, err := resolveTargetField(targetVal, route.targetPath)
+		if err != nil || !targetField.IsValid() || !targetField.CanSet() {
+			continue
+		}
 
-			if !targetField.IsValid() || !targetField.CanSet() {
-				continue
-			}
-		} else {
-			targetField = targetVal.FieldByName(sourceTypeField.Name)
+		if (ctx.shouldIgnoreEmpty() || route.ignoreEmpty) && sourceField.IsZero() {
+			continue
 		}
 
-		convertedVal, err := handleSetField(sourceField, targetField)
+		ctx.pushPath(sourceVal.Type().Field(route.sourceIndex).Name)
+
+		convertedVal, err := handleSetField(sourceField, targetField, ctx)
 		if err != nil {
+			ctx.popPath()
 			return err
 		}
-		switch convertedVal.Kind() {
-		case reflect.Struct, reflect.Slice, reflect.Array, reflect.Map:
-			// Attempt to convert the sourceField to the type expected by targetField.
-			convertedVal2, err2 := convertBasedOnKind(sourceField, targetField.Type())
-			if err2 != nil {
-				return err2
-			}
-
-			// Ensure that convertedVal2 is compatible with targetField's type.
-			// If targetField expects a pointer, ensure convertedVal2 is appropriately addressed.
-			if targetField.Type().Kind() == reflect.Ptr && convertedVal2.Kind() != reflect.Ptr {
-				// If convertedVal2 is not a pointer but targetField expects one, address convertedVal2.
-				if convertedVal2.CanAddr() {
-					targetField.Set(convertedVal2.Addr())
+		// An invalid convertedVal means handleSetField already applied the
+		// result itself (via a registered TypeConverter, a well-known
+		// conversion, or a direct non-DeepCopy assignment) or intentionally
+		// skipped the field; re-deriving or re-converting here would either
+		// discard that result or redo the (possibly side-effecting) work.
+		if convertedVal.IsValid() {
+			switch convertedVal.Kind() {
+			case reflect.Struct, reflect.Slice, reflect.Array, reflect.Map:
+				// Ensure convertedVal is compatible with targetField's type.
+				// If targetField expects a pointer, ensure convertedVal is appropriately addressed.
+				if targetField.Type().Kind() == reflect.Ptr && convertedVal.Kind() != reflect.Ptr {
+					// If convertedVal is not a pointer but targetField expects one, address convertedVal.
+					if convertedVal.CanAddr() {
+						targetField.Set(convertedVal.Addr())
+					} else {
+						// If convertedVal cannot be addressed directly, create a new value and set it.
+						newVal := reflect.New(convertedVal.Type())
+						newVal.Elem().Set(convertedVal)
+						targetField.Set(newVal)
+					}
+				} else if targetField.Type().Kind() != reflect.Ptr && convertedVal.Kind() == reflect.Ptr {
+					// If targetField does not expect a pointer but convertedVal is a pointer, dereference it.
+					targetField.Set(convertedVal.Elem())
 				} else {
-					// If convertedVal2 cannot be addressed directly, create a new value and set it.
-					newVal := reflect.New(convertedVal2.Type())
-					newVal.Elem().Set(convertedVal2)
-					targetField.Set(newVal)
+					// If the types match (both are pointers or both are not pointers), set directly.
+					targetField.Set(convertedVal)
 				}
-			} else if targetField.Type().Kind() != reflect.Ptr && convertedVal2.Kind() == reflect.Ptr {
-				// If targetField does not expect a pointer but convertedVal2 is a pointer, dereference it.
-				targetField.Set(convertedVal2.Elem())
-			} else {
-				// If the types match (both are pointers or both are not pointers), set directly.
-				targetField.Set(convertedVal2)
-			}
-		default:
-			if sourceField.Type().AssignableTo(targetField.Type()) {
-				targetField.Set(sourceField)
-			} else if sourceField.Type().ConvertibleTo(targetField.Type()) {
-				sourceField.Convert(targetField.Type())
+			default:
+				targetField.Set(convertedVal)
 			}
 		}
+
+		mustErr := route.must && targetField.IsZero()
+		ctx.popPath()
+		if mustErr {
+			return fmt.Errorf("field %q is required but was not populated", strings.Join(route.targetPath, "."))
+		}
 	}
 
 	return nil
 }
 
-func handleSetField(sourceField reflect.Value, targetField reflect.Value) (reflect.Value, error) {
+// isNilableKind reports whether reflect.Value.IsNil is valid to call on a
+// value of kind k.
+func isNilableKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+		return true
+	default:
+		return false
+	}
+}
+
+func handleSetField(sourceField reflect.Value, targetField reflect.Value, ctx *conversionContext) (reflect.Value, error) {
 	if !targetField.IsValid() {
 		return reflect.Value{}, nil
 	}
-	if sourceField.Type().AssignableTo(targetField.Type()) {
-		targetField.Set(sourceField)
-		return reflect.Value{}, nil
-	} else {
-		if !sourceField.IsValid() || sourceField.IsNil() || sourceField.IsZero() {
-			return reflect.Value{}, nil
-		}
-		if sourceField.Kind() == reflect.Ptr {
-			sourceField = sourceField.Elem()
+	if fn, ok := ctx.lookup(sourceField.Type(), targetField.Type()); ok {
+		converted, err := callTypeConverter(fn, sourceField, targetField.Type(), ctx)
+		if err != nil {
+			return reflect.Value{}, err
 		}
-		switch sourceField.Kind() {
-		case reflect.Struct, reflect.Slice, reflect.Array, reflect.Map:
-			// Delegate to specific conversion functions based on the kind of source
-			return convertBasedOnKind(sourceField, targetField.Type())
-		default:
-			if sourceField.Type().AssignableTo(targetField.Type()) {
-				return sourceField, nil
-			} else if sourceField.Type().ConvertibleTo(targetField.Type()) {
-				return sourceField.Convert(targetField.Type()), nil
-			}
+		targetField.Set(converted)
+		return reflect.Value{}, nil
+	}
+	if converted, ok, err := tryWellKnownConversion(sourceField, targetField.Type(), ctx); ok {
+		if err != nil {
+			return reflect.Value{}, err
 		}
+		targetField.Set(converted)
+		return reflect.Value{}, nil
+	}
+	if !ctx.shouldDeepCopy() && sourceField.Type().AssignableTo(targetField.Type()) {
+		targetField.Set(sourceField)
+		return reflect.Value{}, nil
+	}
+
+	if !sourceField.IsValid() || isNilableKind(sourceField.Kind()) && sourceField.IsNil() || sourceField.IsZero() {
+		return reflect.Value{}, nil
 	}
 
-	return reflect.Value{}, errors.New("invalid_type_conversion")
+	// Delegate to convertValue rather than re-deriving pointer/struct/slice/map
+	// dispatch by hand: convertValue already carries the DeepCopy visited-
+	// pointer cache, so a pointer field is handed over still wearing its
+	// Ptr kind instead of being dereferenced here first, which is what lets
+	// self-referential graphs (linked lists, tree parents) round-trip to a
+	// shared target pointer instead of an infinite or duplicated copy.
+	return convertValue(sourceField, targetField.Type(), ctx)
 }
@@ -0,0 +1,229 @@
+package struct_converter
+
+import (
+	"testing"
+)
+
+func TestConvertStructsBasicFields(t *testing.T) {
+	type Source struct {
+		Name string
+		Age  int
+	}
+	type Target struct {
+		Name string
+		Age  int
+	}
+
+	src := Source{Name: "Alice", Age: 30}
+	var dst Target
+	if err := ConvertStructs(&src, &dst, ""); err != nil {
+		t.Fatalf("ConvertStructs returned error: %v", err)
+	}
+	if dst != (Target{Name: "Alice", Age: 30}) {
+		t.Fatalf("got %+v, want %+v", dst, Target{Name: "Alice", Age: 30})
+	}
+}
+
+func TestConvertStructsWithOptionsDeepCopyKeepsScalarFields(t *testing.T) {
+	type Source struct {
+		Name string
+		Age  int
+	}
+	type Target struct {
+		Name string
+		Age  int
+	}
+
+	src := Source{Name: "Alice", Age: 30}
+	var dst Target
+	if err := ConvertStructsWithOptions(&src, &dst, "", Options{DeepCopy: true}); err != nil {
+		t.Fatalf("ConvertStructsWithOptions returned error: %v", err)
+	}
+	if dst != (Target{Name: "Alice", Age: 30}) {
+		t.Fatalf("DeepCopy dropped scalar fields: got %+v, want %+v", dst, Target{Name: "Alice", Age: 30})
+	}
+}
+
+func TestConvertStructsWithOptionsIdentityConverterIsApplied(t *testing.T) {
+	type Source struct {
+		Secret string
+	}
+	type Target struct {
+		Secret string
+	}
+
+	src := Source{Secret: "plaintext"}
+	var dst Target
+	opts := Options{
+		Converters: []TypeConverter{
+			{
+				SrcType: "",
+				DstType: "",
+				Fn: func(src interface{}, _ Scope) (interface{}, error) {
+					return "REDACTED", nil
+				},
+			},
+		},
+	}
+	if err := ConvertStructsWithOptions(&src, &dst, "", opts); err != nil {
+		t.Fatalf("ConvertStructsWithOptions returned error: %v", err)
+	}
+	if dst.Secret != "REDACTED" {
+		t.Fatalf("identity TypeConverter was overwritten by raw source value: got %q", dst.Secret)
+	}
+}
+
+func TestConvertStructsWithScopeReportsTopLevelFieldPath(t *testing.T) {
+	type Weird struct{ X int }
+	type Source struct {
+		ZIP Weird
+	}
+	type Target struct {
+		ZIP int
+	}
+
+	src := Source{ZIP: Weird{X: 1}}
+	var dst Target
+	err := ConvertStructsWithScope(&src, &dst, "", Options{}, nil)
+	if err == nil {
+		t.Fatal("expected an error for an unconvertible top-level field")
+	}
+	const want = "failed at ZIP: "
+	if !containsPrefix(err.Error(), want) {
+		t.Fatalf("error %q does not start with %q", err.Error(), want)
+	}
+}
+
+func containsPrefix(s, prefix string) bool {
+	return len(s) >= len(prefix) && s[:len(prefix)] == prefix
+}
+
+func TestConvertStructsTagAppliesToNestedStructFields(t *testing.T) {
+	type InnerSource struct {
+		Town string `converter:"City"`
+	}
+	type InnerTarget struct {
+		City string
+	}
+	type Source struct {
+		Inner InnerSource
+	}
+	type Target struct {
+		Inner InnerTarget
+	}
+
+	src := Source{Inner: InnerSource{Town: "Metropolis"}}
+	var dst Target
+	if err := ConvertStructs(&src, &dst, "converter"); err != nil {
+		t.Fatalf("ConvertStructs returned error: %v", err)
+	}
+	if dst.Inner.City != "Metropolis" {
+		t.Fatalf("rename tag was not applied one level deep: got %+v", dst)
+	}
+}
+
+func TestConvertStructsNestedTypeConverterInvokedOnce(t *testing.T) {
+	type InnerSource struct {
+		Value string
+	}
+	type InnerTarget struct {
+		Value string
+	}
+	type Source struct {
+		Inner InnerSource
+	}
+	type Target struct {
+		Inner InnerTarget
+	}
+
+	var calls int
+	opts := Options{
+		DeepCopy: true,
+		Converters: []TypeConverter{
+			{
+				SrcType: "",
+				DstType: "",
+				Fn: func(src interface{}, _ Scope) (interface{}, error) {
+					calls++
+					return src, nil
+				},
+			},
+		},
+	}
+
+	src := Source{Inner: InnerSource{Value: "x"}}
+	var dst Target
+	if err := ConvertStructsWithOptions(&src, &dst, "", opts); err != nil {
+		t.Fatalf("ConvertStructsWithOptions returned error: %v", err)
+	}
+	if dst.Inner.Value != "x" {
+		t.Fatalf("nested field not converted: got %+v", dst)
+	}
+	if calls != 1 {
+		t.Fatalf("registered TypeConverter invoked %d times for a single field, want 1", calls)
+	}
+}
+
+func TestConvertStructsWithOptionsDeepCopyPreservesCycles(t *testing.T) {
+	type Node struct {
+		Value int
+		Next  *Node
+	}
+	type Wrapper struct {
+		Head *Node
+	}
+
+	a := &Node{Value: 1}
+	b := &Node{Value: 2}
+	a.Next = b
+	b.Next = a
+
+	src := Wrapper{Head: a}
+	var dst Wrapper
+	if err := ConvertStructsWithOptions(&src, &dst, "", Options{DeepCopy: true}); err != nil {
+		t.Fatalf("ConvertStructsWithOptions returned error: %v", err)
+	}
+
+	if dst.Head == nil || dst.Head == src.Head {
+		t.Fatalf("DeepCopy did not allocate a new Head node: %+v", dst.Head)
+	}
+	if dst.Head.Value != 1 || dst.Head.Next == nil || dst.Head.Next.Value != 2 {
+		t.Fatalf("cyclic graph values not copied correctly: %+v", dst.Head)
+	}
+	if dst.Head.Next.Next != dst.Head {
+		t.Fatalf("cycle was not preserved: dst.Head.Next.Next should be dst.Head itself")
+	}
+	if dst.Head.Next == src.Head.Next {
+		t.Fatalf("DeepCopy shared the source's Next node instead of copying it")
+	}
+}
+
+func TestConvertStructsNestedStructAndSlice(t *testing.T) {
+	type Address struct {
+		City string
+		ZIP  int
+	}
+	type SourceUser struct {
+		Name      string
+		Addresses []Address
+	}
+	type TargetUser struct {
+		Name      string
+		Addresses []Address
+	}
+
+	src := SourceUser{
+		Name: "Bob",
+		Addresses: []Address{
+			{City: "Springfield", ZIP: 11111},
+			{City: "Shelbyville", ZIP: 22222},
+		},
+	}
+	var dst TargetUser
+	if err := ConvertStructs(&src, &dst, ""); err != nil {
+		t.Fatalf("ConvertStructs returned error: %v", err)
+	}
+	if len(dst.Addresses) != 2 || dst.Addresses[1].ZIP != 22222 {
+		t.Fatalf("nested slice of structs not converted correctly: %+v", dst)
+	}
+}
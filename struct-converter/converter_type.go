@@ -0,0 +1,229 @@
+package struct_converter
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// typePair identifies a registered conversion function by its concrete
+// source and destination types.
+type typePair struct {
+	source reflect.Type
+	dest   reflect.Type
+}
+
+// typeNamePair identifies a single named field on a struct type, used to key
+// the field-mapping tables on Converter.
+type typeNamePair struct {
+	typ   reflect.Type
+	field string
+}
+
+// FieldRef identifies a single named field on a struct type. SourceFromDest
+// returns these so callers outside the package can inspect a registered
+// field mapping.
+type FieldRef struct {
+	Type  reflect.Type
+	Field string
+}
+
+// Converter is a stateful, reusable alternative to ConvertStructs. Callers
+// register conversion functions and field mappings once up front, and
+// Convert reuses those lookups across many calls. Convert matches fields by
+// name or by registered mapping; it does not read struct tags. Modeled after
+// k8s.io/apimachinery/pkg/conversion.Converter.
+type Converter struct {
+	funcs            map[typePair]reflect.Value
+	structFieldDests map[typeNamePair][]typeNamePair
+	sourceFromDest   map[typeNamePair][]typeNamePair
+	inputDefaults    map[reflect.Type]reflect.Value
+
+	// Logger, if set, receives trace output emitted via Scope.Logf from
+	// conversion funcs registered with a func(a A, b *B, s Scope) error shape.
+	Logger DebugLogger
+}
+
+var scopeType = reflect.TypeOf((*Scope)(nil)).Elem()
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// NewConverter returns an empty, ready-to-use Converter.
+func NewConverter() *Converter {
+	return &Converter{
+		funcs:            make(map[typePair]reflect.Value),
+		structFieldDests: make(map[typeNamePair][]typeNamePair),
+		sourceFromDest:   make(map[typeNamePair][]typeNamePair),
+		inputDefaults:    make(map[reflect.Type]reflect.Value),
+	}
+}
+
+// RegisterConversionFunc registers fn as the conversion from A to B. fn must
+// have the shape func(a A, b *B) error, or func(a A, b *B, s Scope) error if
+// it wants field-path context and access to the DebugLogger. Convert prefers
+// a registered function for a type pair over the reflect-based fallback.
+func (c *Converter) RegisterConversionFunc(fn interface{}) error {
+	fnVal := reflect.ValueOf(fn)
+	fnType := fnVal.Type()
+	if fnType.Kind() != reflect.Func || fnType.NumOut() != 1 {
+		return fmt.Errorf("RegisterConversionFunc: expected func(a A, b *B) error or func(a A, b *B, s Scope) error, got %s", fnType)
+	}
+	if fnType.NumIn() != 2 && fnType.NumIn() != 3 {
+		return fmt.Errorf("RegisterConversionFunc: expected 2 or 3 arguments, got %s", fnType)
+	}
+	if fnType.In(1).Kind() != reflect.Ptr {
+		return fmt.Errorf("RegisterConversionFunc: second argument must be a pointer, got %s", fnType.In(1))
+	}
+	if fnType.NumIn() == 3 && fnType.In(2) != scopeType {
+		return fmt.Errorf("RegisterConversionFunc: third argument must be struct_converter.Scope, got %s", fnType.In(2))
+	}
+	if !fnType.Out(0).Implements(errorType) {
+		return fmt.Errorf("RegisterConversionFunc: return type must be error, got %s", fnType.Out(0))
+	}
+
+	pair := typePair{source: fnType.In(0), dest: fnType.In(1).Elem()}
+	c.funcs[pair] = fnVal
+	return nil
+}
+
+// RegisterInputDefaults registers fn, which must have the shape
+// func(a *A). Convert applies it to a source of type A passed as a *A before
+// the reflect-based field-by-field conversion runs. It is not applied when a
+// conversion function is registered for the type pair, when the source is
+// passed by value, or to nested fields of type A.
+func (c *Converter) RegisterInputDefaults(fn interface{}) error {
+	fnVal := reflect.ValueOf(fn)
+	fnType := fnVal.Type()
+	if fnType.Kind() != reflect.Func || fnType.NumIn() != 1 || fnType.In(0).Kind() != reflect.Ptr {
+		return fmt.Errorf("RegisterInputDefaults: expected func(a *A), got %s", fnType)
+	}
+	c.inputDefaults[fnType.In(0).Elem()] = fnVal
+	return nil
+}
+
+// RegisterFieldMapping declares that srcField on srcType maps to dstField on
+// dstType, even when the names differ. The reverse mapping is recorded at
+// the same time via SourceFromDest, so a single call serves conversions in
+// either direction.
+func (c *Converter) RegisterFieldMapping(srcType reflect.Type, srcField string, dstType reflect.Type, dstField string) {
+	srcKey := typeNamePair{typ: srcType, field: srcField}
+	dstKey := typeNamePair{typ: dstType, field: dstField}
+	c.structFieldDests[srcKey] = append(c.structFieldDests[srcKey], dstKey)
+	c.sourceFromDest[dstKey] = append(c.sourceFromDest[dstKey], srcKey)
+}
+
+// SourceFromDest returns the source type/field pairs registered as feeding
+// into dstType's dstField, the reverse of RegisterFieldMapping.
+func (c *Converter) SourceFromDest(dstType reflect.Type, dstField string) []FieldRef {
+	pairs := c.sourceFromDest[typeNamePair{typ: dstType, field: dstField}]
+	refs := make([]FieldRef, len(pairs))
+	for i, p := range pairs {
+		refs[i] = FieldRef{Type: p.typ, Field: p.field}
+	}
+	return refs
+}
+
+// Convert converts src into dst, which must be a pointer. It first looks
+// for a registered conversion function for the concrete (src, dst) type
+// pair; if none is registered it falls back to reflect-based field-by-field
+// conversion, consulting any mappings registered via RegisterFieldMapping.
+func (c *Converter) Convert(src, dst interface{}) error {
+	dstVal := reflect.ValueOf(dst)
+	if dstVal.Kind() != reflect.Ptr {
+		return errors.New("dst must be a pointer")
+	}
+
+	srcType := reflect.TypeOf(src)
+	if srcType.Kind() == reflect.Ptr {
+		srcType = srcType.Elem()
+	}
+	pair := typePair{source: srcType, dest: dstVal.Type().Elem()}
+
+	if fn, ok := c.funcs[pair]; ok {
+		return c.callConversionFunc(fn, src, dst, nil)
+	}
+
+	return c.convertByReflection(reflect.ValueOf(src), dstVal, nil)
+}
+
+func (c *Converter) callConversionFunc(fn reflect.Value, src, dst interface{}, path []string) error {
+	fnType := fn.Type()
+	srcVal := reflect.ValueOf(src)
+	if srcVal.Kind() == reflect.Ptr && fnType.In(0).Kind() != reflect.Ptr {
+		srcVal = srcVal.Elem()
+	}
+
+	args := []reflect.Value{srcVal, reflect.ValueOf(dst)}
+	if fnType.NumIn() == 3 {
+		sc := &pathScope{path: path, srcType: fnType.In(0), dstType: fnType.In(1).Elem(), logger: c.Logger}
+		args = append(args, reflect.ValueOf(sc))
+	}
+
+	results := fn.Call(args)
+	if err, _ := results[0].Interface().(error); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *Converter) convertByReflection(srcVal, dstVal reflect.Value, path []string) error {
+	if srcVal.Kind() == reflect.Ptr {
+		srcVal = srcVal.Elem()
+	}
+	dstVal = dstVal.Elem()
+
+	if srcVal.Kind() != reflect.Struct || dstVal.Kind() != reflect.Struct {
+		return errors.New("source or target is not a struct")
+	}
+
+	if defaulter, ok := c.inputDefaults[srcVal.Type()]; ok && srcVal.CanAddr() {
+		defaulter.Call([]reflect.Value{srcVal.Addr()})
+	}
+
+	srcType := srcVal.Type()
+	for i := 0; i < srcVal.NumField(); i++ {
+		field := srcType.Field(i)
+		fieldVal := srcVal.Field(i)
+		if !fieldVal.CanInterface() {
+			continue
+		}
+
+		dests := c.structFieldDests[typeNamePair{typ: srcType, field: field.Name}]
+		if len(dests) == 0 {
+			// Converting in the reverse direction of a registered mapping.
+			for _, rev := range c.sourceFromDest[typeNamePair{typ: srcType, field: field.Name}] {
+				if rev.typ == dstVal.Type() {
+					dests = append(dests, rev)
+				}
+			}
+		}
+		if len(dests) == 0 {
+			dests = []typeNamePair{{typ: dstVal.Type(), field: field.Name}}
+		}
+
+		for _, dest := range dests {
+			dstField := dstVal.FieldByName(dest.field)
+			if !dstField.IsValid() || !dstField.CanSet() {
+				continue
+			}
+			fieldPath := append(append([]string{}, path...), field.Name)
+
+			if fn, ok := c.funcs[typePair{source: fieldVal.Type(), dest: dstField.Type()}]; ok {
+				if !dstField.CanAddr() {
+					return fmt.Errorf("converting field %q: destination field is not addressable", field.Name)
+				}
+				if err := c.callConversionFunc(fn, fieldVal.Interface(), dstField.Addr().Interface(), fieldPath); err != nil {
+					return fmt.Errorf("converting field %q: %w", field.Name, err)
+				}
+				continue
+			}
+
+			converted, err := convertValue(fieldVal, dstField.Type(), nil)
+			if err != nil {
+				return fmt.Errorf("converting field %q: %w", field.Name, err)
+			}
+			dstField.Set(converted)
+		}
+	}
+
+	return nil
+}
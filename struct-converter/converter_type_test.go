@@ -0,0 +1,103 @@
+package struct_converter
+
+import (
+	"reflect"
+	"testing"
+)
+
+type ctSource struct {
+	Name string
+	Age  int
+}
+
+type ctTarget struct {
+	Name string
+	Age  int
+}
+
+func TestConverterConvertByReflection(t *testing.T) {
+	c := NewConverter()
+
+	src := ctSource{Name: "Eve", Age: 25}
+	var dst ctTarget
+	if err := c.Convert(&src, &dst); err != nil {
+		t.Fatalf("Convert returned error: %v", err)
+	}
+	if dst != (ctTarget{Name: "Eve", Age: 25}) {
+		t.Fatalf("got %+v, want %+v", dst, ctTarget{Name: "Eve", Age: 25})
+	}
+}
+
+func TestConverterRegisterConversionFuncWithScope(t *testing.T) {
+	c := NewConverter()
+
+	var gotPath string
+	err := c.RegisterConversionFunc(func(s ctSource, d *ctTarget, scope Scope) error {
+		gotPath = scope.Path()
+		d.Name = s.Name
+		d.Age = s.Age
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("RegisterConversionFunc returned error: %v", err)
+	}
+
+	src := ctSource{Name: "Frank", Age: 40}
+	var dst ctTarget
+	if err := c.Convert(&src, &dst); err != nil {
+		t.Fatalf("Convert returned error: %v", err)
+	}
+	if dst != (ctTarget{Name: "Frank", Age: 40}) {
+		t.Fatalf("got %+v, want %+v", dst, ctTarget{Name: "Frank", Age: 40})
+	}
+	if gotPath != "" {
+		t.Fatalf("top-level conversion should have an empty path, got %q", gotPath)
+	}
+}
+
+func TestConverterRegisterFieldMapping(t *testing.T) {
+	type Source struct {
+		FullName string
+	}
+	type Target struct {
+		Name string
+	}
+
+	c := NewConverter()
+	c.RegisterFieldMapping(
+		reflect.TypeOf(Source{}), "FullName",
+		reflect.TypeOf(Target{}), "Name",
+	)
+
+	src := Source{FullName: "Grace"}
+	var dst Target
+	if err := c.Convert(&src, &dst); err != nil {
+		t.Fatalf("Convert returned error: %v", err)
+	}
+	if dst.Name != "Grace" {
+		t.Fatalf("field mapping not applied: got %+v", dst)
+	}
+}
+
+func TestConverterSourceFromDestReturnsUsableFieldRefs(t *testing.T) {
+	type Source struct {
+		FullName string
+	}
+	type Target struct {
+		Name string
+	}
+
+	c := NewConverter()
+	c.RegisterFieldMapping(
+		reflect.TypeOf(Source{}), "FullName",
+		reflect.TypeOf(Target{}), "Name",
+	)
+
+	refs := c.SourceFromDest(reflect.TypeOf(Target{}), "Name")
+	if len(refs) != 1 {
+		t.Fatalf("got %d refs, want 1", len(refs))
+	}
+	if refs[0].Type != reflect.TypeOf(Source{}) || refs[0].Field != "FullName" {
+		t.Fatalf("got %+v, want Type=%v Field=FullName", refs[0], reflect.TypeOf(Source{}))
+	}
+}
@@ -0,0 +1,176 @@
+package struct_converter
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// TypeConverter registers a custom conversion between a pair of concrete
+// types, bypassing the default AssignableTo/ConvertibleTo reflection logic.
+// SrcType and DstType only need to carry type information; their values are
+// never read, so passing a zero value of the desired type (e.g. time.Time{})
+// is the idiomatic way to register one.
+type TypeConverter struct {
+	SrcType interface{}
+	DstType interface{}
+	// Fn receives the Scope of the field currently being converted, so it can
+	// report errors with the same field-path context the library uses
+	// internally and write to the DebugLogger configured via
+	// ConvertStructsWithScope.
+	Fn func(src interface{}, scope Scope) (interface{}, error)
+}
+
+// Options configures a single conversion performed via ConvertStructsWithOptions.
+type Options struct {
+	// IgnoreEmpty skips source fields that hold their zero value instead of
+	// overwriting the corresponding target field with it.
+	IgnoreEmpty bool
+	// DeepCopy forces slices, maps and pointers to be allocated anew rather
+	// than sharing backing memory with the source.
+	DeepCopy bool
+	// Converters registers custom conversions for arbitrary source/target
+	// type pairs, consulted before the default reflection-based conversion.
+	Converters []TypeConverter
+}
+
+// converterPair is the lookup key for a registered TypeConverter.
+type converterPair struct {
+	src reflect.Type
+	dst reflect.Type
+}
+
+// conversionContext carries per-conversion settings through the recursive
+// convert* helpers. A nil *conversionContext is treated as the zero Options.
+type conversionContext struct {
+	ignoreEmpty bool
+	deepCopy    bool
+	converters  map[converterPair]func(interface{}, Scope) (interface{}, error)
+	// visited maps a source pointer's address to the target pointer already
+	// allocated for it, so DeepCopy mode can follow cycles and shared
+	// references without recursing forever or losing sharing on the target.
+	visited map[uintptr]reflect.Value
+	// path accumulates field-path segments (names and "[index]" markers) as
+	// the recursive convert* helpers descend, so errors can be reported with
+	// a full scope path and user funcs can be handed a Scope.
+	path   []string
+	logger DebugLogger
+	// tagName is the struct tag consulted for rename/ignore/must/ignoreempty
+	// routing, carried on the context so nested convertStruct calls apply the
+	// same field plan as the top-level entry point.
+	tagName string
+}
+
+func newConversionContext(opts Options) *conversionContext {
+	ctx := &conversionContext{
+		ignoreEmpty: opts.IgnoreEmpty,
+		deepCopy:    opts.DeepCopy,
+	}
+	if len(opts.Converters) > 0 {
+		ctx.converters = make(map[converterPair]func(interface{}, Scope) (interface{}, error), len(opts.Converters))
+		for _, tc := range opts.Converters {
+			pair := converterPair{src: reflect.TypeOf(tc.SrcType), dst: reflect.TypeOf(tc.DstType)}
+			ctx.converters[pair] = tc.Fn
+		}
+	}
+	if opts.DeepCopy {
+		ctx.visited = make(map[uintptr]reflect.Value)
+	}
+	return ctx
+}
+
+// visitedPointer returns the target pointer previously allocated for the
+// source pointer at addr, if any.
+func (c *conversionContext) visitedPointer(addr uintptr) (reflect.Value, bool) {
+	if c == nil || c.visited == nil {
+		return reflect.Value{}, false
+	}
+	v, ok := c.visited[addr]
+	return v, ok
+}
+
+// recordPointer remembers that the source pointer at addr has been assigned
+// the given freshly-allocated target pointer.
+func (c *conversionContext) recordPointer(addr uintptr, target reflect.Value) {
+	if c == nil || c.visited == nil {
+		return
+	}
+	c.visited[addr] = target
+}
+
+// pushPath and popPath track the current field path as the recursive
+// convert* helpers descend into fields, slice elements and map entries. Both
+// are no-ops on a nil context, such as the one Converter's reflect-based
+// fallback hands to convertValue.
+func (c *conversionContext) pushPath(segment string) {
+	if c == nil {
+		return
+	}
+	c.path = append(c.path, segment)
+}
+
+func (c *conversionContext) popPath() {
+	if c == nil || len(c.path) == 0 {
+		return
+	}
+	c.path = c.path[:len(c.path)-1]
+}
+
+func (c *conversionContext) currentPath() string {
+	if c == nil {
+		return ""
+	}
+	return (&pathScope{path: c.path}).Path()
+}
+
+// wrapErr prefixes err with the current field path the first time it is
+// returned from a leaf conversion failure. Intermediate callers simply
+// propagate the error unchanged so the path isn't wrapped more than once.
+func (c *conversionContext) wrapErr(err error) error {
+	if err == nil || c == nil {
+		return err
+	}
+	path := c.currentPath()
+	if path == "" {
+		return err
+	}
+	return fmt.Errorf("failed at %s: %w", path, err)
+}
+
+// newScope snapshots the context's current path into a Scope for handing to
+// a user-registered conversion func.
+func (c *conversionContext) newScope(srcType, dstType reflect.Type) Scope {
+	var logger DebugLogger
+	path := make([]string, 0)
+	if c != nil {
+		logger = c.logger
+		path = append(path, c.path...)
+	}
+	return &pathScope{path: path, srcType: srcType, dstType: dstType, logger: logger}
+}
+
+// lookup returns the registered converter function for the exact src/dst
+// type pair, if any.
+func (c *conversionContext) lookup(srcType, dstType reflect.Type) (func(interface{}, Scope) (interface{}, error), bool) {
+	if c == nil || c.converters == nil {
+		return nil, false
+	}
+	fn, ok := c.converters[converterPair{src: srcType, dst: dstType}]
+	return fn, ok
+}
+
+func (c *conversionContext) shouldIgnoreEmpty() bool {
+	return c != nil && c.ignoreEmpty
+}
+
+func (c *conversionContext) shouldDeepCopy() bool {
+	return c != nil && c.deepCopy
+}
+
+// fieldTagName returns the struct tag to consult for field routing, or ""
+// (plain field-name matching) on a nil context.
+func (c *conversionContext) fieldTagName() string {
+	if c == nil {
+		return ""
+	}
+	return c.tagName
+}
@@ -0,0 +1,55 @@
+package struct_converter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestConversionContextPushPopPath(t *testing.T) {
+	ctx := newConversionContext(Options{})
+	ctx.pushPath("User")
+	ctx.pushPath("Addresses")
+	ctx.pushPath("[3]")
+	ctx.pushPath("ZIP")
+
+	want := "User.Addresses[3].ZIP"
+	if got := ctx.currentPath(); got != want {
+		t.Fatalf("currentPath() = %q, want %q", got, want)
+	}
+
+	ctx.popPath()
+	if got := ctx.currentPath(); got != "User.Addresses[3]" {
+		t.Fatalf("currentPath() after popPath = %q", got)
+	}
+}
+
+func TestConversionContextLookup(t *testing.T) {
+	fn := func(src interface{}, _ Scope) (interface{}, error) {
+		return src, nil
+	}
+	ctx := newConversionContext(Options{
+		Converters: []TypeConverter{
+			{SrcType: "", DstType: 0, Fn: fn},
+		},
+	})
+
+	_, ok := ctx.lookup(reflect.TypeOf(""), reflect.TypeOf(0))
+	if !ok {
+		t.Fatal("lookup did not find registered converter for (string, int)")
+	}
+	if _, ok := ctx.lookup(reflect.TypeOf(0), reflect.TypeOf("")); ok {
+		t.Fatal("lookup found a converter for a pair that was never registered")
+	}
+}
+
+func TestConversionContextNilIsSafe(t *testing.T) {
+	var ctx *conversionContext
+	ctx.pushPath("anything")
+	ctx.popPath()
+	if got := ctx.currentPath(); got != "" {
+		t.Fatalf("nil conversionContext currentPath() = %q, want empty", got)
+	}
+	if ctx.shouldDeepCopy() || ctx.shouldIgnoreEmpty() {
+		t.Fatal("nil conversionContext should report zero Options")
+	}
+}
@@ -0,0 +1,72 @@
+package struct_converter
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// DebugLogger receives optional tracing output from a conversion. Any type
+// satisfying this (including the standard library's *log.Logger) can be
+// plugged in via ConvertStructsWithScope.
+type DebugLogger interface {
+	Printf(format string, args ...interface{})
+}
+
+// Scope describes the position of a conversion within a larger struct graph.
+// It is passed to user-registered conversion funcs (see
+// Converter.RegisterConversionFunc) so they can report errors with the same
+// field-path context the library itself uses, e.g.
+// "failed at User.Addresses[3].ZIP: cannot convert string to int".
+type Scope interface {
+	// Path returns the current field path, e.g. "User.Addresses[3].ZIP".
+	Path() string
+	// SrcType and DstType report the types being converted at this point.
+	SrcType() reflect.Type
+	DstType() reflect.Type
+	// Errorf builds an error prefixed with the current field path.
+	Errorf(format string, args ...interface{}) error
+	// Logf writes to the configured DebugLogger, if any, and is a no-op otherwise.
+	Logf(format string, args ...interface{})
+}
+
+// pathScope is the default Scope implementation, built from the path
+// tracked on a conversionContext.
+type pathScope struct {
+	path    []string
+	srcType reflect.Type
+	dstType reflect.Type
+	logger  DebugLogger
+}
+
+func (s *pathScope) Path() string {
+	var b strings.Builder
+	for _, seg := range s.path {
+		if strings.HasPrefix(seg, "[") {
+			b.WriteString(seg)
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(seg)
+	}
+	return b.String()
+}
+
+func (s *pathScope) SrcType() reflect.Type { return s.srcType }
+func (s *pathScope) DstType() reflect.Type { return s.dstType }
+
+func (s *pathScope) Errorf(format string, args ...interface{}) error {
+	msg := fmt.Sprintf(format, args...)
+	if path := s.Path(); path != "" {
+		return fmt.Errorf("failed at %s: %s", path, msg)
+	}
+	return fmt.Errorf("%s", msg)
+}
+
+func (s *pathScope) Logf(format string, args ...interface{}) {
+	if s.logger != nil {
+		s.logger.Printf(format, args...)
+	}
+}
@@ -0,0 +1,45 @@
+package struct_converter
+
+import "testing"
+
+type recordingLogger struct {
+	messages []string
+}
+
+func (l *recordingLogger) Printf(format string, args ...interface{}) {
+	l.messages = append(l.messages, format)
+}
+
+func TestPathScopePathJoinsSegmentsAndIndices(t *testing.T) {
+	s := &pathScope{path: []string{"User", "Addresses", "[3]", "ZIP"}}
+	if got, want := s.Path(), "User.Addresses[3].ZIP"; got != want {
+		t.Fatalf("Path() = %q, want %q", got, want)
+	}
+}
+
+func TestPathScopeErrorfPrefixesPath(t *testing.T) {
+	s := &pathScope{path: []string{"ZIP"}}
+	err := s.Errorf("cannot convert %s to %s", "string", "int")
+	want := "failed at ZIP: cannot convert string to int"
+	if err.Error() != want {
+		t.Fatalf("Errorf() = %q, want %q", err.Error(), want)
+	}
+
+	empty := &pathScope{}
+	if got, want := empty.Errorf("boom").Error(), "boom"; got != want {
+		t.Fatalf("Errorf() with no path = %q, want %q", got, want)
+	}
+}
+
+func TestPathScopeLogfWritesToLogger(t *testing.T) {
+	logger := &recordingLogger{}
+	s := &pathScope{logger: logger}
+	s.Logf("hello %s", "world")
+	if len(logger.messages) != 1 {
+		t.Fatalf("expected Logf to write to the configured logger, got %v", logger.messages)
+	}
+
+	// Logf on a Scope with no logger configured must be a no-op, not a panic.
+	noLogger := &pathScope{}
+	noLogger.Logf("ignored")
+}
@@ -0,0 +1,114 @@
+package struct_converter
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// fieldRoute is one resolved source->target mapping computed from a
+// struct's converter tags.
+type fieldRoute struct {
+	sourceIndex int
+	targetPath  []string
+	ignore      bool
+	must        bool
+	ignoreEmpty bool
+}
+
+type fieldPlanKey struct {
+	source  reflect.Type
+	target  reflect.Type
+	tagName string
+}
+
+// fieldPlanCache holds the computed []fieldRoute for every struct pair seen
+// so far, keyed by source type, target type and tag name, so repeated
+// conversions between the same types skip tag parsing and reflection lookups.
+var fieldPlanCache sync.Map // fieldPlanKey -> []fieldRoute
+
+// buildFieldPlan computes, and caches, the source field -> target field
+// routes for a struct pair according to the converter tag grammar:
+//
+//	converter:"NewName"              rename the target field
+//	converter:"-"                    skip this field entirely
+//	converter:"User.Address.City"    write into a dotted nested target path
+//	converter:",must"                error if the target field is left empty
+//	converter:",ignoreempty"         skip the field when the source value is zero
+//
+// Flags combine with a rename/path, e.g. `converter:"City,must"`.
+func buildFieldPlan(sourceType, targetType reflect.Type, tagName string) []fieldRoute {
+	key := fieldPlanKey{source: sourceType, target: targetType, tagName: tagName}
+	if cached, ok := fieldPlanCache.Load(key); ok {
+		return cached.([]fieldRoute)
+	}
+
+	plan := make([]fieldRoute, 0, sourceType.NumField())
+	for i := 0; i < sourceType.NumField(); i++ {
+		field := sourceType.Field(i)
+		route := fieldRoute{sourceIndex: i, targetPath: []string{field.Name}}
+
+		if tagName != "" {
+			if raw, ok := field.Tag.Lookup(tagName); ok {
+				route = applyFieldTag(route, raw, field.Name)
+			}
+		}
+
+		plan = append(plan, route)
+	}
+
+	fieldPlanCache.Store(key, plan)
+	return plan
+}
+
+func applyFieldTag(route fieldRoute, raw, fallbackName string) fieldRoute {
+	if raw == "-" {
+		route.ignore = true
+		return route
+	}
+
+	parts := strings.Split(raw, ",")
+	name := strings.TrimSpace(parts[0])
+	if name != "" {
+		route.targetPath = strings.Split(name, ".")
+	} else {
+		route.targetPath = []string{fallbackName}
+	}
+
+	for _, flag := range parts[1:] {
+		switch strings.TrimSpace(flag) {
+		case "must":
+			route.must = true
+		case "ignoreempty":
+			route.ignoreEmpty = true
+		}
+	}
+
+	return route
+}
+
+// resolveTargetField walks a (possibly dotted) target path, allocating
+// intermediate pointers as needed, and returns the final settable field.
+func resolveTargetField(target reflect.Value, path []string) (reflect.Value, error) {
+	current := target
+	for _, segment := range path {
+		if current.Kind() == reflect.Ptr {
+			if current.IsNil() {
+				if !current.CanSet() {
+					return reflect.Value{}, fmt.Errorf("cannot allocate nested field %q: parent is not settable", segment)
+				}
+				current.Set(reflect.New(current.Type().Elem()))
+			}
+			current = current.Elem()
+		}
+		if current.Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Errorf("cannot resolve field %q: %s is not a struct", segment, current.Kind())
+		}
+		current = current.FieldByName(segment)
+		if !current.IsValid() {
+			return reflect.Value{}, fmt.Errorf("target has no field %q", segment)
+		}
+	}
+	return current, nil
+}
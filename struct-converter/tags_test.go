@@ -0,0 +1,63 @@
+package struct_converter
+
+import "testing"
+
+func TestConvertStructsTagRenameIgnoreAndNestedPath(t *testing.T) {
+	type Source struct {
+		FullName string `converter:"Name"`
+		Internal string `converter:"-"`
+		City     string `converter:"Address.City"`
+	}
+	type Target struct {
+		Name    string
+		Address struct {
+			City string
+		}
+	}
+
+	src := Source{FullName: "Carol", Internal: "skip-me", City: "Metropolis"}
+	var dst Target
+	if err := ConvertStructs(&src, &dst, "converter"); err != nil {
+		t.Fatalf("ConvertStructs returned error: %v", err)
+	}
+	if dst.Name != "Carol" {
+		t.Fatalf("rename tag not applied: got %q", dst.Name)
+	}
+	if dst.Address.City != "Metropolis" {
+		t.Fatalf("dotted nested path tag not applied: got %q", dst.Address.City)
+	}
+}
+
+func TestConvertStructsTagMustErrorsWhenFieldLeftEmpty(t *testing.T) {
+	type Source struct {
+		Email string `converter:",must"`
+	}
+	type Target struct {
+		Email string
+	}
+
+	var src Source
+	var dst Target
+	err := ConvertStructs(&src, &dst, "converter")
+	if err == nil {
+		t.Fatal("expected an error for a ,must field left at its zero value")
+	}
+}
+
+func TestConvertStructsTagIgnoreEmptySkipsZeroSource(t *testing.T) {
+	type Source struct {
+		Name string `converter:",ignoreempty"`
+	}
+	type Target struct {
+		Name string
+	}
+
+	var src Source
+	dst := Target{Name: "keep-me"}
+	if err := ConvertStructs(&src, &dst, "converter"); err != nil {
+		t.Fatalf("ConvertStructs returned error: %v", err)
+	}
+	if dst.Name != "keep-me" {
+		t.Fatalf("ignoreempty tag overwrote target with zero source value: got %q", dst.Name)
+	}
+}
@@ -0,0 +1,84 @@
+package struct_converter
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"reflect"
+)
+
+var (
+	scannerType         = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+	valuerType          = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// tryWellKnownConversion special-cases source/target pairs whose value can
+// only be reached through an interface method, so AssignableTo/ConvertibleTo
+// would otherwise reject them outright: database/sql's Scanner/Valuer pairs
+// (sql.NullString<->string, sql.NullInt64<->*int64, time.Time<->sql.NullTime,
+// ...) and encoding.TextMarshaler/TextUnmarshaler. ok is false when none of
+// these apply, so the caller should fall back to its normal conversion path.
+func tryWellKnownConversion(source reflect.Value, targetType reflect.Type, ctx *conversionContext) (result reflect.Value, ok bool, err error) {
+	if !source.IsValid() || source.Type().AssignableTo(targetType) {
+		return reflect.Value{}, false, nil
+	}
+
+	// Target implements sql.Scanner (always on a pointer receiver): hand it
+	// the raw source value, e.g. (*sql.NullString).Scan("x"). A pointer
+	// source (e.g. *int64) is dereferenced first since Scan expects the
+	// pointed-to value, not the pointer itself.
+	if reflect.PointerTo(targetType).Implements(scannerType) {
+		scanSource := source
+		if scanSource.Kind() == reflect.Ptr {
+			if scanSource.IsNil() {
+				return reflect.Zero(targetType), true, nil
+			}
+			scanSource = scanSource.Elem()
+		}
+		targetPtr := reflect.New(targetType)
+		if scanErr := targetPtr.Interface().(sql.Scanner).Scan(scanSource.Interface()); scanErr != nil {
+			return reflect.Value{}, true, ctx.wrapErr(scanErr)
+		}
+		return targetPtr.Elem(), true, nil
+	}
+
+	// Source implements driver.Valuer: pull out its underlying value and
+	// convert that instead, e.g. sql.NullString{...}.Value() -> string.
+	if source.Type().Implements(valuerType) {
+		val, valErr := source.Interface().(driver.Valuer).Value()
+		if valErr != nil {
+			return reflect.Value{}, true, ctx.wrapErr(valErr)
+		}
+		if val == nil {
+			return reflect.Zero(targetType), true, nil
+		}
+		valVal := reflect.ValueOf(val)
+		// val is often already the exact target type (e.g. sql.NullTime.Value()
+		// returns a time.Time): assign it directly rather than falling into
+		// convertValue, which for a struct Kind would recurse field-by-field
+		// and silently drop unexported fields like time.Time's.
+		if valVal.Type().AssignableTo(targetType) {
+			return valVal, true, nil
+		}
+		converted, convErr := convertValue(valVal, targetType, ctx)
+		return converted, true, convErr
+	}
+
+	// encoding.TextMarshaler -> encoding.TextUnmarshaler round-trip, e.g.
+	// time.Time <-> a string-backed custom type.
+	if source.Type().Implements(textMarshalerType) && reflect.PointerTo(targetType).Implements(textUnmarshalerType) {
+		text, marshalErr := source.Interface().(encoding.TextMarshaler).MarshalText()
+		if marshalErr != nil {
+			return reflect.Value{}, true, ctx.wrapErr(marshalErr)
+		}
+		targetPtr := reflect.New(targetType)
+		if unmarshalErr := targetPtr.Interface().(encoding.TextUnmarshaler).UnmarshalText(text); unmarshalErr != nil {
+			return reflect.Value{}, true, ctx.wrapErr(unmarshalErr)
+		}
+		return targetPtr.Elem(), true, nil
+	}
+
+	return reflect.Value{}, false, nil
+}
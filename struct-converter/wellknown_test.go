@@ -0,0 +1,63 @@
+package struct_converter
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+func TestConvertStructsPointerToNullInt64RoundTrips(t *testing.T) {
+	type Source struct {
+		Age *int64
+	}
+	type Target struct {
+		Age sql.NullInt64
+	}
+
+	v := int64(42)
+	src := Source{Age: &v}
+	var dst Target
+	if err := ConvertStructs(&src, &dst, ""); err != nil {
+		t.Fatalf("ConvertStructs returned error: %v", err)
+	}
+	if !dst.Age.Valid || dst.Age.Int64 != 42 {
+		t.Fatalf("pointer source did not round-trip through sql.Scanner: got %+v", dst.Age)
+	}
+}
+
+func TestConvertStructsNullTimeToTimeRoundTrips(t *testing.T) {
+	type Source struct {
+		CreatedAt sql.NullTime
+	}
+	type Target struct {
+		CreatedAt time.Time
+	}
+
+	want := time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC)
+	src := Source{CreatedAt: sql.NullTime{Time: want, Valid: true}}
+	var dst Target
+	if err := ConvertStructs(&src, &dst, ""); err != nil {
+		t.Fatalf("ConvertStructs returned error: %v", err)
+	}
+	if !dst.CreatedAt.Equal(want) {
+		t.Fatalf("sql.NullTime did not round-trip into time.Time: got %v, want %v", dst.CreatedAt, want)
+	}
+}
+
+func TestConvertStructsStringToNullStringRoundTrips(t *testing.T) {
+	type Source struct {
+		Name string
+	}
+	type Target struct {
+		Name sql.NullString
+	}
+
+	src := Source{Name: "Dave"}
+	var dst Target
+	if err := ConvertStructs(&src, &dst, ""); err != nil {
+		t.Fatalf("ConvertStructs returned error: %v", err)
+	}
+	if !dst.Name.Valid || dst.Name.String != "Dave" {
+		t.Fatalf("string did not convert into sql.NullString: got %+v", dst.Name)
+	}
+}